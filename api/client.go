@@ -0,0 +1,30 @@
+package api
+
+import (
+	"duckdb-version-manager/models"
+	"duckdb-version-manager/stacktrace"
+	"net/http"
+	"time"
+)
+
+// ChecksumBundle holds the SHA256SUMS file published alongside a release and, when the
+// release includes one, its detached minisign signature.
+type ChecksumBundle struct {
+	Content   []byte
+	Signature *string
+}
+
+type Client interface {
+	Get() *http.Client
+
+	GetRelease(version string) (*models.RemoteVersionInfo, stacktrace.Error)
+	ListAllReleases() ([]models.RemoteVersionInfo, stacktrace.Error)
+	GetChecksums(release *models.RemoteVersionInfo) (*ChecksumBundle, stacktrace.Error)
+	GetReleaseNotes(version string) (string, stacktrace.Error)
+
+	LatestDuckVmRelease(timeout time.Duration) (*models.RemoteVersionInfo, stacktrace.Error)
+
+	ListExtensions() ([]string, stacktrace.Error)
+	GetExtensionDownloadUrl(extensionName string, version string, platform models.Platform) (string, stacktrace.Error)
+	GetExtensionSignature(extensionName string, version string, platform models.Platform) (string, stacktrace.Error)
+}
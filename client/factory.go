@@ -2,15 +2,14 @@ package client
 
 import (
 	"net/http"
-	"time"
 )
 
 func New() Client {
 	return &ApiClient{
 		Host: "https://raw.githubusercontent.com/NiclasHaderer/duckdb-version-manager/main/",
-		Client: &http.Client{
-			Timeout: 5 * time.Second,
-		},
+		// No overall request timeout: large DuckDB archives are streamed with their own
+		// idle-read timeout (see manager.downloadWithResume) instead of a fixed deadline.
+		Client:   &http.Client{},
 		BasePath: "/versions",
 	}
 }
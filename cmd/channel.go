@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"duckdb-version-manager/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var channelCmd = &cobra.Command{
+	Use:   "channel [stable|preview|nightly]",
+	Short: "Get or set the preferred DuckDB release channel",
+	Args:  cobra.MaximumNArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{manager.ChannelStable, manager.ChannelPreview, manager.ChannelNightly}, cobra.ShellCompDirectiveNoFileComp
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			fmt.Println(manager.Run.GetChannel())
+			return
+		}
+
+		if err := manager.Run.SetChannel(args[0]); err != nil {
+			utils.ExitWithError(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(channelCmd)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"duckdb-version-manager/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var deactivateCmd = &cobra.Command{
+	Use:    "deactivate",
+	Short:  "Remove the current shell's duckman shim directory (used by 'duckman init')",
+	Hidden: true,
+	Args:   cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := manager.Run.DeactivateShim(); err != nil {
+			utils.ExitWithError(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(deactivateCmd)
+}
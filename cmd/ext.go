@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"duckdb-version-manager/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var extForVersion string
+var extListAvailable bool
+
+var extCmd = &cobra.Command{
+	Use:   "ext",
+	Short: "Manage DuckDB extensions for installed versions",
+}
+
+var extInstallCmd = &cobra.Command{
+	Use:   "install <extension>",
+	Short: "Install a DuckDB extension for a version",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := manager.Run.InstallExtension(args[0], resolveExtVersion()); err != nil {
+			utils.ExitWithError(err)
+		}
+	},
+}
+
+var extListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List installed extensions, or remote ones with --available",
+	Run: func(cmd *cobra.Command, args []string) {
+		if extListAvailable {
+			available, err := manager.Run.ListAvailableExtensions()
+			if err != nil {
+				utils.ExitWithError(err)
+			}
+			for _, extensionName := range available {
+				fmt.Println(extensionName)
+			}
+			return
+		}
+
+		extensions, err := manager.Run.ListExtensions(resolveExtVersion())
+		if err != nil {
+			utils.ExitWithError(err)
+		}
+		for extensionName, info := range extensions {
+			fmt.Printf("%s\t%s\t%s\n", extensionName, info.Source, info.InstallDate)
+		}
+	},
+}
+
+var extRemoveCmd = &cobra.Command{
+	Use:   "remove <extension>",
+	Short: "Remove a DuckDB extension from a version",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := manager.Run.RemoveExtension(args[0], resolveExtVersion()); err != nil {
+			utils.ExitWithError(err)
+		}
+	},
+}
+
+var extUpdateCmd = &cobra.Command{
+	Use:   "update <extension>",
+	Short: "Reinstall a DuckDB extension to pick up its latest build",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := manager.Run.InstallExtension(args[0], resolveExtVersion()); err != nil {
+			utils.ExitWithError(err)
+		}
+	},
+}
+
+var extPinCmd = &cobra.Command{
+	Use:   "pin",
+	Short: "Record the currently installed extensions so they are reinstalled after an upgrade",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := manager.Run.PinExtensions(resolveExtVersion()); err != nil {
+			utils.ExitWithError(err)
+		}
+	},
+}
+
+func resolveExtVersion() string {
+	resolvedVersion, _, err := manager.Run.ResolveVersion(extForVersion)
+	if err != nil {
+		utils.ExitWithError(err)
+	}
+	return resolvedVersion
+}
+
+func init() {
+	for _, extSubCmd := range []*cobra.Command{extInstallCmd, extListCmd, extRemoveCmd, extUpdateCmd, extPinCmd} {
+		extSubCmd.Flags().StringVar(&extForVersion, "for", "", "DuckDB version to act on (defaults to the resolved version)")
+	}
+	extListCmd.Flags().BoolVar(&extListAvailable, "available", false, "List extensions available in the remote extension index instead of installed ones")
+
+	extCmd.AddCommand(extInstallCmd, extListCmd, extRemoveCmd, extUpdateCmd, extPinCmd)
+	rootCmd.AddCommand(extCmd)
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"duckdb-version-manager/stacktrace"
+	"duckdb-version-manager/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+const bashZshInit = `duckman() {
+  if [ "$1" = "use" ]; then
+    local shim_dir
+    shim_dir="$(command duckman use "$2")" || return $?
+    if [ -z "$DUCKMAN_OLD_PATH" ]; then
+      export DUCKMAN_OLD_PATH="$PATH"
+    fi
+    export PATH="${shim_dir}:${PATH}"
+    export DUCKMAN_SHIM_DIR="$shim_dir"
+    export DUCKMAN_CURRENT_VERSION="$2"
+  elif [ "$1" = "deactivate" ]; then
+    command duckman deactivate || return $?
+    if [ -n "$DUCKMAN_OLD_PATH" ]; then
+      export PATH="$DUCKMAN_OLD_PATH"
+    fi
+    unset DUCKMAN_OLD_PATH DUCKMAN_SHIM_DIR DUCKMAN_CURRENT_VERSION
+  else
+    command duckman "$@"
+  fi
+}
+`
+
+const fishInit = `function duckman
+    if test "$argv[1]" = "use"
+        set -gx DUCKMAN_SHIM_DIR (command duckman use $argv[2])
+        if not set -q DUCKMAN_OLD_PATH
+            set -gx DUCKMAN_OLD_PATH $PATH
+        end
+        set -gx PATH $DUCKMAN_SHIM_DIR $PATH
+        set -gx DUCKMAN_CURRENT_VERSION $argv[2]
+    else if test "$argv[1]" = "deactivate"
+        command duckman deactivate; or return $status
+        if set -q DUCKMAN_OLD_PATH
+            set -gx PATH $DUCKMAN_OLD_PATH
+        end
+        set -e DUCKMAN_OLD_PATH
+        set -e DUCKMAN_SHIM_DIR
+        set -e DUCKMAN_CURRENT_VERSION
+    else
+        command duckman $argv
+    end
+end
+`
+
+const powershellInit = `function duckman {
+    param([Parameter(ValueFromRemainingArguments=$true)] $Args)
+    if ($Args[0] -eq "use") {
+        $env:DUCKMAN_SHIM_DIR = & duckman.exe use $Args[1]
+        if (-not $env:DUCKMAN_OLD_PATH) {
+            $env:DUCKMAN_OLD_PATH = $env:Path
+        }
+        $env:Path = "$($env:DUCKMAN_SHIM_DIR);$($env:Path)"
+        $env:DUCKMAN_CURRENT_VERSION = $Args[1]
+    } elseif ($Args[0] -eq "deactivate") {
+        & duckman.exe deactivate
+        if ($env:DUCKMAN_OLD_PATH) {
+            $env:Path = $env:DUCKMAN_OLD_PATH
+        }
+        Remove-Item Env:\DUCKMAN_OLD_PATH, Env:\DUCKMAN_SHIM_DIR, Env:\DUCKMAN_CURRENT_VERSION -ErrorAction SilentlyContinue
+    } else {
+        & duckman.exe @Args
+    }
+}
+`
+
+var initCmd = &cobra.Command{
+	Use:       "init <bash|zsh|fish|powershell>",
+	Short:     "Print a shell function that lets 'duckman use' switch versions in the current shell",
+	Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+	Run: func(cmd *cobra.Command, args []string) {
+		switch args[0] {
+		case "bash", "zsh":
+			fmt.Print(bashZshInit)
+		case "fish":
+			fmt.Print(fishInit)
+		case "powershell":
+			fmt.Print(powershellInit)
+		default:
+			utils.ExitWithError(stacktrace.NewF("unsupported shell '%s'", args[0]))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+}
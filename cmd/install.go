@@ -2,17 +2,41 @@ package cmd
 
 import (
 	"duckdb-version-manager/manager"
+	"duckdb-version-manager/stacktrace"
 	"duckdb-version-manager/utils"
 	"github.com/spf13/cobra"
 )
 
+var insecureSkipVerify bool
+var installChannel string
+var installQuiet bool
+
 var installCmd = &cobra.Command{
-	Use:               "install [version]",
-	Short:             "Install a specific version of DuckDB",
-	Args:              cobra.ExactArgs(1),
+	Use:               "install <version> [version...]",
+	Short:             "Install one or more versions of DuckDB",
+	Args:              cobra.MinimumNArgs(1),
 	ValidArgsFunction: manager.Run.RemoteVersionList,
 	Run: func(cmd *cobra.Command, args []string) {
-		err := manager.Run.InstallVersion(args[0])
+		versionsToInstall := make([]string, len(args))
+		for i, requestedVersion := range args {
+			if requestedVersion != "latest" {
+				versionsToInstall[i] = requestedVersion
+				continue
+			}
+
+			resolvedVersion, err := manager.Run.ResolveLatestVersion(installChannel)
+			if err != nil {
+				utils.ExitWithError(err)
+			}
+			versionsToInstall[i] = resolvedVersion
+		}
+
+		var err stacktrace.Error
+		if len(versionsToInstall) == 1 {
+			err = manager.Run.InstallVersionWithOptions(versionsToInstall[0], insecureSkipVerify, installQuiet)
+		} else {
+			err = manager.Run.InstallVersions(versionsToInstall, insecureSkipVerify, installQuiet)
+		}
 		if err != nil {
 			utils.ExitWithError(err)
 		}
@@ -21,5 +45,8 @@ var installCmd = &cobra.Command{
 }
 
 func init() {
+	installCmd.Flags().BoolVar(&insecureSkipVerify, "insecure-skip-verify", false, "Skip checksum and signature verification of the downloaded asset")
+	installCmd.Flags().StringVar(&installChannel, "channel", "", "Channel to resolve 'latest' against (defaults to the configured channel)")
+	installCmd.Flags().BoolVar(&installQuiet, "quiet", false, "Suppress the download progress bar")
 	rootCmd.AddCommand(installCmd)
 }
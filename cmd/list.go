@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"duckdb-version-manager/stacktrace"
+	"duckdb-version-manager/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var listRemote bool
+var listAll bool
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List installed DuckDB versions, or remote ones with --remote",
+	Run: func(cmd *cobra.Command, args []string) {
+		if !listRemote {
+			if listAll {
+				utils.ExitWithError(stacktrace.NewF("--all only applies together with --remote"))
+			}
+			for _, installation := range manager.Run.ListInstalledVersions() {
+				fmt.Println(installation.Version)
+			}
+			return
+		}
+
+		remoteVersions, err := manager.Run.ListRemoteVersions(listAll)
+		if err != nil {
+			utils.ExitWithError(err)
+		}
+
+		for _, release := range remoteVersions {
+			if release.Retracted {
+				fmt.Printf("%s (retracted: %s)\n", release.Version, release.RetractionReason)
+				continue
+			}
+			fmt.Println(release.Version)
+		}
+	},
+}
+
+func init() {
+	listCmd.Flags().BoolVar(&listRemote, "remote", false, "List versions available upstream instead of installed ones")
+	listCmd.Flags().BoolVar(&listAll, "all", false, "Include retracted versions (only with --remote)")
+	rootCmd.AddCommand(listCmd)
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"duckdb-version-manager/stacktrace"
+	"duckdb-version-manager/utils"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var unsetLocalVersion bool
+
+var localCmd = &cobra.Command{
+	Use:               "local [version]",
+	Short:             "Pin a DuckDB version to the current directory",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: manager.Run.LocalVersionList,
+	Run: func(cmd *cobra.Command, args []string) {
+		path, err := localVersionFilePath()
+		if err != nil {
+			utils.ExitWithError(err)
+		}
+
+		if unsetLocalVersion {
+			if removeErr := os.Remove(path); removeErr != nil && !os.IsNotExist(removeErr) {
+				utils.ExitWithError(stacktrace.Wrap(removeErr))
+			}
+			fmt.Printf("Removed local version pin at %s\n", path)
+			return
+		}
+
+		if len(args) != 1 {
+			utils.ExitWithError(stacktrace.NewF("expected a version, or --unset"))
+			return
+		}
+
+		if writeErr := os.WriteFile(path, []byte(args[0]+"\n"), 0644); writeErr != nil {
+			utils.ExitWithError(stacktrace.Wrap(writeErr))
+		}
+		fmt.Printf("Pinned '%s' to %s\n", args[0], path)
+	},
+}
+
+func localVersionFilePath() (string, stacktrace.Error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", stacktrace.Wrap(err)
+	}
+	return filepath.Join(cwd, manager.LocalVersionFileName), nil
+}
+
+func init() {
+	localCmd.Flags().BoolVar(&unsetLocalVersion, "unset", false, "Remove the local version pin from the current directory")
+	rootCmd.AddCommand(localCmd)
+}
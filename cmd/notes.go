@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"duckdb-version-manager/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var notesCmd = &cobra.Command{
+	Use:               "notes [version]",
+	Short:             "Show the release notes for a DuckDB version",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: manager.Run.RemoteVersionList,
+	Run: func(cmd *cobra.Command, args []string) {
+		releaseVersion := ""
+		if len(args) == 1 {
+			releaseVersion = args[0]
+		}
+
+		if releaseVersion == "" {
+			resolvedVersion, _, err := manager.Run.ResolveVersion("")
+			if err != nil {
+				utils.ExitWithError(err)
+			}
+			releaseVersion = resolvedVersion
+		}
+
+		notes, err := manager.Run.GetReleaseNotes(releaseVersion)
+		if err != nil {
+			utils.ExitWithError(err)
+		}
+
+		if err := utils.Page(notes); err != nil {
+			utils.ExitWithError(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(notesCmd)
+}
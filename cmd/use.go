@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"duckdb-version-manager/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var useCmd = &cobra.Command{
+	Use:               "use <version>",
+	Short:             "Point the duckman shim at a version for the current shell (see 'duckman init')",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: manager.Run.LocalVersionList,
+	Run: func(cmd *cobra.Command, args []string) {
+		shimDir, err := manager.Run.ShimPath(args[0])
+		if err != nil {
+			utils.ExitWithError(err)
+		}
+		fmt.Println(shimDir)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(useCmd)
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var verifyCmd = &cobra.Command{
+	Use:               "verify [version]",
+	Short:             "Re-check an installed DuckDB binary against its recorded checksum",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: manager.Run.LocalVersionList,
+	Run: func(cmd *cobra.Command, args []string) {
+		versions := args
+		if len(versions) == 0 {
+			installed := manager.Run.ListInstalledVersions()
+			for _, installation := range installed {
+				versions = append(versions, installation.Version)
+			}
+		}
+
+		failed := false
+		for _, version := range versions {
+			if err := manager.Run.VerifyInstallation(version); err != nil {
+				failed = true
+				fmt.Printf("%s: FAILED (%s)\n", version, err)
+				continue
+			}
+			fmt.Printf("%s: OK\n", version)
+		}
+
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+}
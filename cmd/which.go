@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"duckdb-version-manager/manager"
+	"duckdb-version-manager/utils"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var whichCmd = &cobra.Command{
+	Use:               "which [version]",
+	Short:             "Print the resolved DuckDB binary path and how it was resolved",
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: manager.Run.LocalVersionList,
+	Run: func(cmd *cobra.Command, args []string) {
+		explicitVersion := ""
+		if len(args) == 1 {
+			explicitVersion = args[0]
+		}
+
+		resolvedVersion, source, err := manager.Run.ResolveVersion(explicitVersion)
+		if err != nil {
+			utils.ExitWithError(err)
+		}
+
+		release, err := manager.Run.GetLocalReleaseInfo(resolvedVersion)
+		if err != nil {
+			utils.ExitWithError(err)
+		}
+
+		fmt.Printf("%s (%s)\n", release.Location, source)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(whichCmd)
+}
@@ -0,0 +1,64 @@
+package manager
+
+import (
+	"duckdb-version-manager/models"
+	"duckdb-version-manager/stacktrace"
+	"duckdb-version-manager/utils"
+)
+
+const (
+	ChannelStable  = "stable"
+	ChannelPreview = "preview"
+	ChannelNightly = "nightly"
+)
+
+var channels = []string{ChannelStable, ChannelPreview, ChannelNightly}
+
+func (v *versionManagerImpl) GetChannel() string {
+	if v.localConfig.PreferredChannel == nil {
+		return ChannelStable
+	}
+	return *v.localConfig.PreferredChannel
+}
+
+func (v *versionManagerImpl) SetChannel(channel string) stacktrace.Error {
+	if !utils.Contains(channels, channel) {
+		return stacktrace.NewF("unknown channel '%s', expected one of %v", channel, channels)
+	}
+
+	v.localConfig.PreferredChannel = &channel
+	return v.saveConfig()
+}
+
+func (v *versionManagerImpl) ResolveLatestVersion(channel string) (string, stacktrace.Error) {
+	if channel == "" {
+		channel = v.GetChannel()
+	}
+
+	remoteVersions, err := v.client.ListAllReleases()
+	if err != nil {
+		return "", err
+	}
+
+	onChannel := filterRetracted(filterByChannel(remoteVersions, channel))
+	if len(onChannel) == 0 {
+		return "", stacktrace.NewF("no non-retracted releases found on channel '%s'", channel)
+	}
+
+	versionList := toVersionList(onChannel)
+	return versionList[len(versionList)-1], nil
+}
+
+func (v *versionManagerImpl) GetReleaseNotes(version string) (string, stacktrace.Error) {
+	return v.client.GetReleaseNotes(version)
+}
+
+func filterByChannel(versions []models.RemoteVersionInfo, channel string) []models.RemoteVersionInfo {
+	if channel == "" {
+		return versions
+	}
+
+	return utils.Filter(versions, func(release models.RemoteVersionInfo) bool {
+		return release.Channel == channel
+	})
+}
@@ -0,0 +1,113 @@
+package manager
+
+import (
+	"context"
+	"duckdb-version-manager/config"
+	"duckdb-version-manager/stacktrace"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/schollz/progressbar/v3"
+	"golang.org/x/term"
+)
+
+// idleReadTimeout bounds how long a download may go without making progress, rather than
+// bounding its total duration the way a fixed http.Client timeout would.
+const idleReadTimeout = 30 * time.Second
+
+// resumeKey must be unique per downloaded asset (a version, or an extension/version/platform
+// combination); it names the partial file under config.VersionDir/.partial and the progress
+// bar's description. barOut, if non-nil, receives the bar's output instead of os.Stdout
+// directly, so concurrent callers can each own a fixed terminal line.
+func downloadWithResume(client *http.Client, url string, resumeKey string, quiet bool, barOut io.Writer) (string, stacktrace.Error) {
+	partialDir := config.VersionDir + "/.partial"
+	if err := os.MkdirAll(partialDir, 0700); err != nil {
+		return "", stacktrace.Wrap(err)
+	}
+	partialPath := partialDir + "/" + resumeKey
+
+	var alreadyDownloaded int64
+	if info, err := os.Stat(partialPath); err == nil {
+		alreadyDownloaded = info.Size()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", stacktrace.Wrap(err)
+	}
+	if alreadyDownloaded > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", alreadyDownloaded))
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return "", stacktrace.Wrap(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusPartialContent {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return "", stacktrace.NewF("download of %s failed: server returned %s: %s", resumeKey, response.Status, string(body))
+	}
+
+	openFlags := os.O_CREATE | os.O_WRONLY
+	if response.StatusCode == http.StatusPartialContent {
+		openFlags |= os.O_APPEND
+	} else {
+		alreadyDownloaded = 0
+		openFlags |= os.O_TRUNC
+	}
+
+	file, err := os.OpenFile(partialPath, openFlags, 0700)
+	if err != nil {
+		return "", stacktrace.Wrap(err)
+	}
+	defer file.Close()
+
+	var destination io.Writer = file
+	if !quiet && term.IsTerminal(int(os.Stdout.Fd())) {
+		var bar *progressbar.ProgressBar
+		if barOut != nil {
+			bar = progressbar.NewOptions64(alreadyDownloaded+response.ContentLength,
+				progressbar.OptionSetWriter(barOut),
+				progressbar.OptionSetDescription("downloading "+resumeKey),
+				progressbar.OptionShowBytes(true),
+				progressbar.OptionThrottle(65*time.Millisecond),
+				progressbar.OptionOnCompletion(func() { fmt.Fprint(barOut, "\n") }),
+			)
+		} else {
+			bar = progressbar.DefaultBytes(alreadyDownloaded+response.ContentLength, "downloading "+resumeKey)
+		}
+		_ = bar.Add64(alreadyDownloaded)
+		destination = io.MultiWriter(file, bar)
+	}
+
+	if _, err := io.Copy(destination, newIdleTimeoutReader(response.Body, idleReadTimeout, cancel)); err != nil {
+		return "", stacktrace.Wrap(err)
+	}
+
+	return partialPath, nil
+}
+
+// idleTimeoutReader cancels its context if no bytes have been read for `timeout`.
+type idleTimeoutReader struct {
+	reader  io.Reader
+	timeout time.Duration
+	timer   *time.Timer
+}
+
+func newIdleTimeoutReader(reader io.Reader, timeout time.Duration, onIdle context.CancelFunc) io.Reader {
+	return &idleTimeoutReader{reader: reader, timeout: timeout, timer: time.AfterFunc(timeout, onIdle)}
+}
+
+func (r *idleTimeoutReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.timer.Reset(r.timeout)
+	return n, err
+}
@@ -0,0 +1,159 @@
+package manager
+
+import (
+	"crypto/sha256"
+	"duckdb-version-manager/config"
+	"duckdb-version-manager/models"
+	"duckdb-version-manager/stacktrace"
+	"duckdb-version-manager/utils"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func (v *versionManagerImpl) ExtensionDir(version string) (string, stacktrace.Error) {
+	release, err := v.GetLocalReleaseInfo(version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(config.VersionDir, ".extensions", release.Version), nil
+}
+
+// Only the core registry is wired up right now; ExtensionSourceCommunity and
+// ExtensionSourceLocal are declared but have no install path yet.
+func (v *versionManagerImpl) InstallExtension(extensionName string, version string) stacktrace.Error {
+	release, err := v.GetLocalReleaseInfo(version)
+	if err != nil {
+		return err
+	}
+
+	deviceInfo := utils.GetDeviceInfo()
+	extensionUrl, err := v.client.GetExtensionDownloadUrl(extensionName, release.Version, deviceInfo.Platform)
+	if err != nil {
+		return err
+	}
+
+	resumeKey := "ext-" + extensionName + "-" + release.Version + "-" + string(deviceInfo.Platform)
+	partialPath, err := downloadWithResume(v.client.Get(), extensionUrl, resumeKey, true, nil)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(partialPath)
+
+	asset, readErr := os.ReadFile(partialPath)
+	if readErr != nil {
+		return stacktrace.Wrap(readErr)
+	}
+
+	signature, err := v.client.GetExtensionSignature(extensionName, release.Version, deviceInfo.Platform)
+	if err != nil {
+		return err
+	}
+	// Extensions aren't guaranteed to be signed by DuckDB's own key, so they get their own trust root.
+	if err := verifySignature(asset, signature, config.DuckDBExtensionSigningPublicKeys); err != nil {
+		return err
+	}
+
+	extensionDir, err := v.ExtensionDir(version)
+	if err != nil {
+		return err
+	}
+	if mkdirErr := os.MkdirAll(extensionDir, 0700); mkdirErr != nil {
+		return stacktrace.Wrap(mkdirErr)
+	}
+
+	extensionFile := filepath.Join(extensionDir, extensionName+".duckdb_extension")
+	if writeErr := os.WriteFile(extensionFile, asset, 0700); writeErr != nil {
+		return stacktrace.Wrap(writeErr)
+	}
+
+	sum := sha256.Sum256(asset)
+	installTime, _ := time.Now().MarshalText()
+
+	v.installMutex.Lock()
+	defer v.installMutex.Unlock()
+
+	// release may be stale if another install updated it while this one was downloading.
+	release, err = v.GetLocalReleaseInfo(version)
+	if err != nil {
+		return err
+	}
+	if release.Extensions == nil {
+		release.Extensions = map[string]models.ExtensionInfo{}
+	}
+	release.Extensions[extensionName] = models.ExtensionInfo{
+		Name:         extensionName,
+		Version:      release.Version,
+		Source:       models.ExtensionSourceCore,
+		InstallDate:  string(installTime),
+		Sha256Digest: hex.EncodeToString(sum[:]),
+	}
+	v.localConfig.LocalInstallations[release.Version] = *release
+
+	return v.saveConfig()
+}
+
+func (v *versionManagerImpl) ListExtensions(version string) (map[string]models.ExtensionInfo, stacktrace.Error) {
+	release, err := v.GetLocalReleaseInfo(version)
+	if err != nil {
+		return nil, err
+	}
+	return release.Extensions, nil
+}
+
+func (v *versionManagerImpl) ListAvailableExtensions() ([]string, stacktrace.Error) {
+	return v.client.ListExtensions()
+}
+
+func (v *versionManagerImpl) RemoveExtension(extensionName string, version string) stacktrace.Error {
+	release, err := v.GetLocalReleaseInfo(version)
+	if err != nil {
+		return err
+	}
+	if _, installed := release.Extensions[extensionName]; !installed {
+		return stacktrace.NewF("extension '%s' is not installed for '%s'", extensionName, release.Version)
+	}
+
+	extensionDir, err := v.ExtensionDir(version)
+	if err != nil {
+		return err
+	}
+	extensionFile := filepath.Join(extensionDir, extensionName+".duckdb_extension")
+	if removeErr := os.Remove(extensionFile); removeErr != nil && !os.IsNotExist(removeErr) {
+		return stacktrace.Wrap(removeErr)
+	}
+
+	v.installMutex.Lock()
+	defer v.installMutex.Unlock()
+
+	delete(release.Extensions, extensionName)
+	v.localConfig.LocalInstallations[release.Version] = *release
+	return v.saveConfig()
+}
+
+func (v *versionManagerImpl) PinExtensions(version string) stacktrace.Error {
+	release, err := v.GetLocalReleaseInfo(version)
+	if err != nil {
+		return err
+	}
+
+	v.installMutex.Lock()
+	defer v.installMutex.Unlock()
+
+	v.localConfig.PinnedExtensions = make([]string, 0, len(release.Extensions))
+	for extensionName := range release.Extensions {
+		v.localConfig.PinnedExtensions = append(v.localConfig.PinnedExtensions, extensionName)
+	}
+
+	return v.saveConfig()
+}
+
+func (v *versionManagerImpl) applyPinnedExtensions(version string) stacktrace.Error {
+	for _, extensionName := range v.localConfig.PinnedExtensions {
+		if err := v.InstallExtension(extensionName, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,22 +1,40 @@
 package manager
 
 import (
+	"crypto/sha256"
 	"duckdb-version-manager/api"
 	"duckdb-version-manager/config"
 	"duckdb-version-manager/models"
 	"duckdb-version-manager/stacktrace"
 	"duckdb-version-manager/utils"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/go-version"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"io"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 )
 
+const LocalVersionFileName = ".duckdb-version"
+
+const (
+	VersionSourceExplicit  = "explicit argument"
+	VersionSourceLocalFile = "local file"
+	VersionSourceDefault   = "default version"
+)
+
 type VersionManager interface {
 	InstallVersion(version string) stacktrace.Error
+	InstallVersionWithOptions(version string, skipVerify bool, quiet bool) stacktrace.Error
+	InstallVersions(versions []string, skipVerify bool, quiet bool) stacktrace.Error
 	UninstallVersion(version string) stacktrace.Error
 	ListInstalledVersions() []models.LocalInstallationInfo
 	GetDefaultVersion() *models.LocalInstallationInfo
@@ -27,46 +45,143 @@ type VersionManager interface {
 	LocalVersionList(cmd *cobra.Command, args []string, complete string) ([]string, cobra.ShellCompDirective)
 	RemoteVersionList(cmd *cobra.Command, args []string, complete string) ([]string, cobra.ShellCompDirective)
 	ShowUpdateWarning()
+	ResolveVersion(explicitVersion string) (version string, source string, err stacktrace.Error)
+	VerifyInstallation(version string) stacktrace.Error
+	GetChannel() string
+	SetChannel(channel string) stacktrace.Error
+	ResolveLatestVersion(channel string) (string, stacktrace.Error)
+	GetReleaseNotes(version string) (string, stacktrace.Error)
+	ShimPath(version string) (string, stacktrace.Error)
+	DeactivateShim() stacktrace.Error
+	ExtensionDir(version string) (string, stacktrace.Error)
+	InstallExtension(extensionName string, version string) stacktrace.Error
+	ListExtensions(version string) (map[string]models.ExtensionInfo, stacktrace.Error)
+	ListAvailableExtensions() ([]string, stacktrace.Error)
+	RemoveExtension(extensionName string, version string) stacktrace.Error
+	PinExtensions(version string) stacktrace.Error
+	ListRemoteVersions(includeRetracted bool) ([]models.RemoteVersionInfo, stacktrace.Error)
 }
 
 type versionManagerImpl struct {
-	client      api.Client
-	localConfig models.LocalConfig
+	client       api.Client
+	localConfig  models.LocalConfig
+	installMutex sync.Mutex
 }
 
 func (v *versionManagerImpl) InstallVersion(version string) stacktrace.Error {
+	return v.InstallVersionWithOptions(version, false, false)
+}
+
+func (v *versionManagerImpl) InstallVersionWithOptions(version string, skipVerify bool, quiet bool) stacktrace.Error {
+	return v.installVersion(version, skipVerify, quiet, nil)
+}
+
+func (v *versionManagerImpl) InstallVersions(versions []string, skipVerify bool, quiet bool) stacktrace.Error {
+	const maxConcurrentInstalls = 4
+
+	var renderer *concurrentProgressRenderer
+	if !quiet && term.IsTerminal(int(os.Stdout.Fd())) {
+		renderer = newConcurrentProgressRenderer(len(versions))
+	}
+
+	semaphore := make(chan struct{}, maxConcurrentInstalls)
+	errs := make(chan stacktrace.Error, len(versions))
+	var wg sync.WaitGroup
+
+	for i, version := range versions {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, version string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			var barOut io.Writer
+			if renderer != nil {
+				barOut = renderer.forRow(i)
+			}
+			if err := v.installVersion(version, skipVerify, quiet, barOut); err != nil {
+				errs <- err
+			}
+		}(i, version)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	var failures []string
+	for err := range errs {
+		failures = append(failures, err.Error())
+	}
+	if len(failures) > 0 {
+		return stacktrace.NewF("failed to install %d of %d version(s): %s", len(failures), len(versions), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func (v *versionManagerImpl) installVersion(version string, skipVerify bool, quiet bool, barOut io.Writer) stacktrace.Error {
 	release, err := v.client.GetRelease(version)
 	if err != nil {
 		return err
 	}
 
+	if release.Retracted {
+		fmt.Printf("\nWARNING: %s has been retracted: %s\n\n", release.Version, release.RetractionReason)
+	}
+
 	downloadUrl, err := utils.GetDownloadUrlFrom(release)
 	if err != nil {
 		return err
 	}
 
-	githubAsset, err := utils.GetResponseBodyFrom(v.client.Get(), *downloadUrl)
+	partialPath, err := downloadWithResume(v.client.Get(), *downloadUrl, release.Version, quiet, barOut)
 	if err != nil {
 		return err
 	}
-	duckDb, err := utils.ExtractDuckdbFile(githubAsset)
-	if err != nil {
+	defer os.Remove(partialPath)
+
+	archive, openErr := os.Open(partialPath)
+	if openErr != nil {
+		return stacktrace.Wrap(openErr)
+	}
+	defer archive.Close()
+
+	// verifyAssetFile only checks the compressed archive against SHA256SUMS; the digest we
+	// persist is of the extracted binary, since that's what `duckman verify` re-hashes.
+	if _, err := v.verifyAssetFile(release, archive, skipVerify); err != nil {
 		return err
 	}
+	if _, seekErr := archive.Seek(0, io.SeekStart); seekErr != nil {
+		return stacktrace.Wrap(seekErr)
+	}
 
 	fileLocation := config.VersionDir + "/" + config.DuckDBName + "-" + release.Version
-	if err := os.WriteFile(fileLocation, duckDb, 0700); err != nil {
-		return stacktrace.Wrap(err)
+	destination, createErr := os.OpenFile(fileLocation, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0700)
+	if createErr != nil {
+		return stacktrace.Wrap(createErr)
 	}
+	defer destination.Close()
+
+	extractedHasher := sha256.New()
+	if err := utils.ExtractDuckdbFileStreaming(archive, io.MultiWriter(destination, extractedHasher)); err != nil {
+		return err
+	}
+	extractedDigest := hex.EncodeToString(extractedHasher.Sum(nil))
 
 	installTime, _ := time.Now().MarshalText()
+
+	v.installMutex.Lock()
 	v.localConfig.LocalInstallations[release.Version] = models.LocalInstallationInfo{
 		Version:          release.Version,
 		Location:         fileLocation,
 		InstallationDate: string(installTime),
+		Sha256Digest:     extractedDigest,
+	}
+	saveErr := v.saveConfig()
+	v.installMutex.Unlock()
+	if saveErr != nil {
+		return saveErr
 	}
 
-	return v.saveConfig()
+	return v.applyPinnedExtensions(release.Version)
 }
 
 func (v *versionManagerImpl) UninstallVersion(unreliableVersion string) stacktrace.Error {
@@ -132,6 +247,44 @@ func (v *versionManagerImpl) SetDefaultVersion(version *string) stacktrace.Error
 	return v.saveConfig()
 }
 
+// sessionShimDir is keyed by the parent shell's PID so concurrent shells don't race on a
+// single global `duckdb` symlink.
+func sessionShimDir() string {
+	return filepath.Join(config.ShimDir, strconv.Itoa(os.Getppid()))
+}
+
+func (v *versionManagerImpl) ShimPath(version string) (string, stacktrace.Error) {
+	release, err := v.GetLocalReleaseInfo(version)
+	if err != nil {
+		return "", err
+	}
+
+	shimDir := sessionShimDir()
+	if err := os.MkdirAll(shimDir, 0700); err != nil {
+		return "", stacktrace.Wrap(err)
+	}
+
+	shimmedDuckDb := filepath.Join(shimDir, config.DuckDBName)
+	if _, err := os.Lstat(shimmedDuckDb); err == nil {
+		if err := os.Remove(shimmedDuckDb); err != nil {
+			return "", stacktrace.Wrap(err)
+		}
+	}
+
+	if err := v.symlink(release.Location, shimmedDuckDb); err != nil {
+		return "", err
+	}
+
+	return shimDir, nil
+}
+
+func (v *versionManagerImpl) DeactivateShim() stacktrace.Error {
+	if err := os.RemoveAll(sessionShimDir()); err != nil {
+		return stacktrace.Wrap(err)
+	}
+	return nil
+}
+
 func (v *versionManagerImpl) symlink(from string, to string) stacktrace.Error {
 	deviceInfo := utils.GetDeviceInfo()
 	if deviceInfo.Platform == models.PlatformWindows {
@@ -192,27 +345,82 @@ func execUnix(args []string) stacktrace.Error {
 }
 
 func (v *versionManagerImpl) Run(version string, args []string) stacktrace.Error {
-	if !v.VersionIsInstalled(version) {
-		err := v.InstallVersion(version)
+	resolvedVersion, _, err := v.ResolveVersion(version)
+	if err != nil {
+		return err
+	}
+
+	if !v.VersionIsInstalled(resolvedVersion) {
+		err := v.InstallVersion(resolvedVersion)
 		if err != nil {
 			return err
 		}
 	}
 
-	release, _ := v.GetLocalReleaseInfo(version)
+	release, _ := v.GetLocalReleaseInfo(resolvedVersion)
 	installationTime, _ := time.Parse(time.RFC3339, release.InstallationDate)
 	isOlderThanOneDay := time.Now().Sub(installationTime) > 24*time.Hour
 	if release.Version == "nightly" && isOlderThanOneDay {
-		err := v.InstallVersion(version)
+		err := v.InstallVersion(resolvedVersion)
 		if err != nil {
 			return err
 		}
 	}
 
+	if extensionDir, err := v.ExtensionDir(resolvedVersion); err == nil {
+		_ = os.Setenv("DUCKDB_EXTENSION_DIRECTORY", extensionDir)
+	}
+
 	args = utils.Prepend(args, release.Location)
 	return exec(args)
 }
 
+func (v *versionManagerImpl) ResolveVersion(explicitVersion string) (string, string, stacktrace.Error) {
+	if explicitVersion != "" {
+		return explicitVersion, VersionSourceExplicit, nil
+	}
+
+	if localVersion, found, err := v.findLocalVersionFile(); found {
+		if err != nil {
+			return "", "", err
+		}
+		return localVersion, VersionSourceLocalFile, nil
+	}
+
+	if v.localConfig.DefaultVersion != nil {
+		return *v.localConfig.DefaultVersion, VersionSourceDefault, nil
+	}
+
+	return "", "", stacktrace.NewF("no version given, no '%s' found and no default version set", LocalVersionFileName)
+}
+
+// found is true as soon as a file is located, even if its content is invalid, so callers can
+// tell "no file anywhere above here" apart from "found one, but it's broken".
+func (v *versionManagerImpl) findLocalVersionFile() (version string, found bool, err stacktrace.Error) {
+	dir, getwdErr := os.Getwd()
+	if getwdErr != nil {
+		return "", false, stacktrace.Wrap(getwdErr)
+	}
+
+	for {
+		path := filepath.Join(dir, LocalVersionFileName)
+		content, readErr := os.ReadFile(path)
+		if readErr == nil {
+			pinnedVersion := strings.TrimSpace(string(content))
+			if pinnedVersion == "" {
+				return "", true, stacktrace.NewF("'%s' is empty", path)
+			}
+			return pinnedVersion, true, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false, nil
+		}
+		dir = parent
+	}
+}
+
 func (v *versionManagerImpl) VersionIsInstalled(version string) bool {
 	_, ok := v.localConfig.LocalInstallations[version]
 
@@ -253,7 +461,8 @@ func (v *versionManagerImpl) RemoteVersionList(_ *cobra.Command, _ []string, _ s
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	}
 
-	return toVersionList(remoteVersions), cobra.ShellCompDirectiveKeepOrder
+	onChannel := filterByChannel(remoteVersions, v.GetChannel())
+	return toVersionList(filterRetracted(onChannel)), cobra.ShellCompDirectiveKeepOrder
 }
 
 func (v *versionManagerImpl) ShowUpdateWarning() {
@@ -268,6 +477,15 @@ func (v *versionManagerImpl) ShowUpdateWarning() {
 	if remoteVersion.GreaterThan(localVersion) {
 		fmt.Println("\nA new version of duckman is available. Run 'duckman update-self' to update.")
 	}
+
+	latestDuckDb, err := v.ResolveLatestVersion(v.GetChannel())
+	if err == nil && v.localConfig.DefaultVersion != nil {
+		remoteDuckDbVersion, remoteErr := version.NewVersion(latestDuckDb)
+		localDuckDbVersion, localErr := version.NewVersion(*v.localConfig.DefaultVersion)
+		if remoteErr == nil && localErr == nil && remoteDuckDbVersion.GreaterThan(localDuckDbVersion) {
+			fmt.Printf("\nDuckDB %s is available on the %s channel. Run 'duckman install %s' to install it.\n", latestDuckDb, v.GetChannel(), latestDuckDb)
+		}
+	}
 }
 
 func toVersionList(versions []models.RemoteVersionInfo) []string {
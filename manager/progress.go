@@ -0,0 +1,42 @@
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// concurrentProgressRenderer reserves a fixed block of terminal rows so several progress
+// bars can redraw in place instead of racing for the same row.
+type concurrentProgressRenderer struct {
+	mu   sync.Mutex
+	rows int
+}
+
+func newConcurrentProgressRenderer(rows int) *concurrentProgressRenderer {
+	for i := 0; i < rows; i++ {
+		fmt.Println()
+	}
+	return &concurrentProgressRenderer{rows: rows}
+}
+
+// forRow returns a writer that redraws only the given row (0-indexed from the top of the
+// reserved block) by moving the cursor up, clearing the line, and moving back down.
+func (r *concurrentProgressRenderer) forRow(row int) *progressRow {
+	return &progressRow{renderer: r, row: row}
+}
+
+type progressRow struct {
+	renderer *concurrentProgressRenderer
+	row      int
+}
+
+func (w *progressRow) Write(p []byte) (int, error) {
+	w.renderer.mu.Lock()
+	defer w.renderer.mu.Unlock()
+
+	up := w.renderer.rows - w.row
+	fmt.Fprintf(os.Stdout, "\x1b[%dA\r\x1b[2K%s\x1b[%dB\r", up, bytes.TrimRight(p, "\r\n"), up)
+	return len(p), nil
+}
@@ -0,0 +1,25 @@
+package manager
+
+import (
+	"duckdb-version-manager/models"
+	"duckdb-version-manager/stacktrace"
+	"duckdb-version-manager/utils"
+)
+
+func filterRetracted(versions []models.RemoteVersionInfo) []models.RemoteVersionInfo {
+	return utils.Filter(versions, func(release models.RemoteVersionInfo) bool {
+		return !release.Retracted
+	})
+}
+
+func (v *versionManagerImpl) ListRemoteVersions(includeRetracted bool) ([]models.RemoteVersionInfo, stacktrace.Error) {
+	remoteVersions, err := v.client.ListAllReleases()
+	if err != nil {
+		return nil, err
+	}
+
+	if includeRetracted {
+		return remoteVersions, nil
+	}
+	return filterRetracted(remoteVersions), nil
+}
@@ -0,0 +1,111 @@
+package manager
+
+import (
+	"crypto/sha256"
+	"duckdb-version-manager/config"
+	"duckdb-version-manager/models"
+	"duckdb-version-manager/stacktrace"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jedisct1/go-minisign"
+)
+
+// checksumBundle holds the SHA256SUMS file published alongside a release and, when the
+// release includes one, its detached minisign signature.
+type checksumBundle struct {
+	Content   []byte
+	Signature *string
+}
+
+func (v *versionManagerImpl) verifyAssetFile(release *models.RemoteVersionInfo, asset io.Reader, skipVerify bool) (string, stacktrace.Error) {
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, asset); err != nil {
+		return "", stacktrace.Wrap(err)
+	}
+	digest := hex.EncodeToString(hasher.Sum(nil))
+
+	if skipVerify {
+		return digest, nil
+	}
+
+	checksums, err := v.client.GetChecksums(release)
+	if err != nil {
+		return "", stacktrace.Wrap(fmt.Errorf("could not fetch SHA256SUMS for '%s' (use --insecure-skip-verify to bypass): %w", release.Version, err))
+	}
+
+	expectedDigest, found := findDigest(checksums.Content, release.AssetName)
+	if !found {
+		return "", stacktrace.NewF("no checksum entry for '%s' in SHA256SUMS", release.AssetName)
+	}
+	if expectedDigest != digest {
+		return "", stacktrace.NewF("checksum mismatch for '%s': expected %s, got %s", release.AssetName, expectedDigest, digest)
+	}
+
+	if checksums.Signature == nil {
+		return digest, nil
+	}
+
+	if err := verifySignature(checksums.Content, *checksums.Signature, config.DuckDBSigningPublicKeys); err != nil {
+		return "", err
+	}
+
+	return digest, nil
+}
+
+func findDigest(sha256Sums []byte, assetName string) (string, bool) {
+	for _, line := range strings.Split(string(sha256Sums), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == assetName {
+			return fields[0], true
+		}
+	}
+	return "", false
+}
+
+func verifySignature(message []byte, signature string, trustedPublicKeys []string) stacktrace.Error {
+	sig, err := minisign.DecodeSignature(signature)
+	if err != nil {
+		return stacktrace.Wrap(err)
+	}
+
+	for _, encodedKey := range trustedPublicKeys {
+		publicKey, err := minisign.NewPublicKey(encodedKey)
+		if err != nil {
+			continue
+		}
+		if valid, _ := publicKey.Verify(message, sig); valid {
+			return nil
+		}
+	}
+
+	return stacktrace.NewF("signature did not verify against any configured public key")
+}
+
+func (v *versionManagerImpl) VerifyInstallation(version string) stacktrace.Error {
+	release, err := v.GetLocalReleaseInfo(version)
+	if err != nil {
+		return err
+	}
+
+	if release.Sha256Digest == "" {
+		return stacktrace.NewF("'%s' was installed before digest verification was recorded; reinstall to verify", release.Version)
+	}
+
+	contents, readErr := os.ReadFile(release.Location)
+	if readErr != nil {
+		return stacktrace.Wrap(readErr)
+	}
+
+	sum := sha256.Sum256(contents)
+	actualDigest := hex.EncodeToString(sum[:])
+
+	if actualDigest != release.Sha256Digest {
+		return stacktrace.NewF("digest mismatch for '%s': expected %s, got %s", release.Version, release.Sha256Digest, actualDigest)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package models
+
+// LocalConfig is the on-disk config persisted at config.File.
+type LocalConfig struct {
+	DefaultVersion     *string
+	PreferredChannel   *string
+	LocalInstallations map[string]LocalInstallationInfo
+	PinnedExtensions   []string
+}
+
+type LocalInstallationInfo struct {
+	Version          string
+	Location         string
+	InstallationDate string
+	Sha256Digest     string
+	Extensions       map[string]ExtensionInfo
+}
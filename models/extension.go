@@ -0,0 +1,19 @@
+package models
+
+// ExtensionSource identifies where an installed extension came from. Only Core is wired up
+// today; see manager.InstallExtension.
+type ExtensionSource string
+
+const (
+	ExtensionSourceCore      ExtensionSource = "core"
+	ExtensionSourceCommunity ExtensionSource = "community"
+	ExtensionSourceLocal     ExtensionSource = "local"
+)
+
+type ExtensionInfo struct {
+	Name         string
+	Version      string
+	Source       ExtensionSource
+	InstallDate  string
+	Sha256Digest string
+}
@@ -0,0 +1,9 @@
+package models
+
+type Platform string
+
+const (
+	PlatformLinux   Platform = "linux"
+	PlatformMacOS   Platform = "macos"
+	PlatformWindows Platform = "windows"
+)
@@ -0,0 +1,9 @@
+package models
+
+type RemoteVersionInfo struct {
+	Version          string
+	Channel          string
+	AssetName        string
+	Retracted        bool
+	RetractionReason string
+}